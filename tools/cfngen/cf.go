@@ -0,0 +1,81 @@
+package cfngen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+)
+
+const templateFormatVersion = "2010-09-09"
+
+// Template is the root of a CloudFormation template, built up by callers from
+// generated resource maps (e.g., the gluecf package) and serialized with
+// CloudFormation().
+type Template struct {
+	AWSTemplateFormatVersion string
+	Description              string
+	Parameters               map[string]interface{} `json:",omitempty"`
+	Resources                map[string]interface{}
+	Outputs                  map[string]interface{} `json:",omitempty"`
+}
+
+// NewTemplate composes a Template from the pieces generators already have in hand.
+// Any of parameters, resources or outputs may be nil.
+func NewTemplate(description string, parameters, resources, outputs map[string]interface{}) *Template {
+	return &Template{
+		AWSTemplateFormatVersion: templateFormatVersion,
+		Description:              description,
+		Parameters:               parameters,
+		Resources:                resources,
+		Outputs:                  outputs,
+	}
+}
+
+// CloudFormation renders the template as indented JSON, the format CloudFormation
+// itself accepts directly.
+func (t *Template) CloudFormation() ([]byte, error) {
+	return json.MarshalIndent(t, "", "  ")
+}
+
+// CloudFormationYAML renders the same resource graph as CloudFormation(), but as
+// YAML, which CloudFormation accepts natively and which is far easier to review
+// in a PR diff. Intrinsic functions (Ref, Fn::GetAtt, Fn::Sub) are rendered using
+// the short-form tags (!Ref, !GetAtt, !Sub) whenever that wouldn't be ambiguous;
+// anything else falls back to the long form CloudFormation also accepts.
+func (t *Template) CloudFormationYAML() ([]byte, error) {
+	// Round-trip through JSON so we operate on the same generic shape the JSON
+	// encoder sees, rather than duplicating struct-tag handling here.
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+
+	node, err := yamlNode(generic)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(node)
+}
@@ -0,0 +1,78 @@
+package gluecf
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import "github.com/panther-labs/panther/tools/cfngen"
+
+// crawlerResourceType is the CloudFormation type name for an AWS Glue crawler.
+const crawlerResourceType = "AWS::Glue::Crawler"
+
+// Crawler is the CloudFormation resource for an AWS::Glue::Crawler.
+type Crawler struct {
+	Type       string
+	Properties CrawlerProperties
+}
+
+// CrawlerProperties is the Properties block of an AWS::Glue::Crawler resource.
+// Role and DatabaseName are interface{} rather than string because they're
+// commonly intrinsics (e.g. an Fn::GetAtt onto an IAM role's Arn, or a Ref onto
+// the database resource) rather than literal values.
+type CrawlerProperties struct {
+	Name         string
+	Role         interface{}
+	DatabaseName interface{}
+	Targets      CrawlerTargets
+}
+
+// CrawlerTargets is the set of data stores a crawler scans; only S3 targets
+// are modeled today.
+type CrawlerTargets struct {
+	S3Targets []S3Target
+}
+
+// S3Target is a single S3 path a crawler scans. Path is interface{} rather
+// than string because it's commonly an Fn::Sub interpolating a bucket
+// resource rather than a literal path.
+type S3Target struct {
+	Path interface{}
+}
+
+// NewCrawler returns the CloudFormation resource for a Glue crawler that
+// populates databaseName from the given S3 paths, using role (typically an
+// intrinsic referencing an IAM role's Arn) to access them.
+func NewCrawler(name string, role, databaseName interface{}, s3Paths ...interface{}) *Crawler {
+	targets := make([]S3Target, len(s3Paths))
+	for i, path := range s3Paths {
+		targets[i] = S3Target{Path: path}
+	}
+
+	return &Crawler{
+		Type: crawlerResourceType,
+		Properties: CrawlerProperties{
+			Name:         name,
+			Role:         role,
+			DatabaseName: databaseName,
+			Targets:      CrawlerTargets{S3Targets: targets},
+		},
+	}
+}
+
+func init() {
+	cfngen.RegisterResource(crawlerResourceType, func() interface{} { return &Crawler{} })
+}
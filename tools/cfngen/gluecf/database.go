@@ -0,0 +1,60 @@
+package gluecf
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import "github.com/panther-labs/panther/tools/cfngen"
+
+// databaseResourceType is the CloudFormation type name for an AWS Glue database.
+const databaseResourceType = "AWS::Glue::Database"
+
+// Database is the CloudFormation resource for an AWS::Glue::Database.
+type Database struct {
+	Type       string
+	Properties DatabaseProperties
+}
+
+// DatabaseProperties is the Properties block of an AWS::Glue::Database resource.
+type DatabaseProperties struct {
+	CatalogID     string `json:"CatalogId"`
+	DatabaseInput DatabaseInput
+}
+
+// DatabaseInput describes the Glue database being created.
+type DatabaseInput struct {
+	Name        string
+	Description string
+}
+
+// NewDatabase returns the CloudFormation resource for a Glue database in catalogID.
+func NewDatabase(catalogID, name, description string) *Database {
+	return &Database{
+		Type: databaseResourceType,
+		Properties: DatabaseProperties{
+			CatalogID: catalogID,
+			DatabaseInput: DatabaseInput{
+				Name:        name,
+				Description: description,
+			},
+		},
+	}
+}
+
+func init() {
+	cfngen.RegisterResource(databaseResourceType, func() interface{} { return &Database{} })
+}
@@ -19,6 +19,7 @@ package gluecf
  */
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"testing"
 
@@ -46,3 +47,69 @@ func TestDatabase(t *testing.T) {
 	require.NoError(t, err)
 	assert.JSONEq(t, string(expected), string(cf))
 }
+
+func TestDatabaseYAML(t *testing.T) {
+	dbName := "db1"
+	resources := map[string]interface{}{
+		dbName: NewDatabase("12345", dbName, "Test db"),
+	}
+
+	cfTemplate := cfngen.NewTemplate("Test template", nil, resources, nil)
+	cf, err := cfTemplate.CloudFormationYAML()
+	require.NoError(t, err)
+
+	const expectedFile = "testdata/db.template.yaml"
+	// uncomment to write new expected file
+	// require.NoError(t, ioutil.WriteFile(expectedFile, cf, 0644))
+
+	expected, err := ioutil.ReadFile(expectedFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(cf))
+}
+
+// TestIntrinsicsYAML exercises Ref, Fn::GetAtt (both the string and the
+// two-element array form) and Fn::Sub (both the plain-string short form and
+// the two-element [template, vars] form, which has no unambiguous short form
+// and must fall back to long-form YAML) across a small resource graph, so a
+// regression in any one translation in yaml.go shows up as a golden-file diff.
+func TestIntrinsicsYAML(t *testing.T) {
+	resources := map[string]interface{}{
+		"db1":    NewDatabase("12345", "db1", "Test db"),
+		"table1": NewTable("12345", map[string]interface{}{"Ref": "db1"}, "table1", "Test table"),
+		"crawler1": NewCrawler(
+			"crawler1",
+			map[string]interface{}{"Fn::GetAtt": "db1.Arn"},
+			map[string]interface{}{"Ref": "db1"},
+			map[string]interface{}{"Fn::GetAtt": []interface{}{"db1", "Arn"}},
+			map[string]interface{}{"Fn::Sub": "s3://${db1}/data"},
+			map[string]interface{}{"Fn::Sub": []interface{}{
+				"s3://${Bucket}/${Key}",
+				map[string]interface{}{"Bucket": "my-bucket", "Key": "my-key"},
+			}},
+		),
+	}
+
+	cfTemplate := cfngen.NewTemplate("Test template", nil, resources, nil)
+	cf, err := cfTemplate.CloudFormationYAML()
+	require.NoError(t, err)
+
+	const expectedFile = "testdata/graph.template.yaml"
+	// uncomment to write new expected file
+	// require.NoError(t, ioutil.WriteFile(expectedFile, cf, 0644))
+
+	expected, err := ioutil.ReadFile(expectedFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(cf))
+}
+
+func TestDatabaseUnmarshalJSON(t *testing.T) {
+	raw, err := ioutil.ReadFile("testdata/db.template.json")
+	require.NoError(t, err)
+
+	var cfTemplate cfngen.Template
+	require.NoError(t, json.Unmarshal(raw, &cfTemplate))
+
+	db, ok := cfTemplate.Resources["db1"].(*Database)
+	require.True(t, ok, "expected db1 to unmarshal as *Database, got %T", cfTemplate.Resources["db1"])
+	assert.Equal(t, NewDatabase("12345", "db1", "Test db"), db)
+}
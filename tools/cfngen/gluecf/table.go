@@ -0,0 +1,66 @@
+package gluecf
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import "github.com/panther-labs/panther/tools/cfngen"
+
+// tableResourceType is the CloudFormation type name for an AWS Glue table.
+const tableResourceType = "AWS::Glue::Table"
+
+// Table is the CloudFormation resource for an AWS::Glue::Table.
+type Table struct {
+	Type       string
+	Properties TableProperties
+}
+
+// TableProperties is the Properties block of an AWS::Glue::Table resource.
+// DatabaseName is interface{} rather than string because it's commonly an
+// intrinsic (e.g. {"Ref": "<database logical id>"}) rather than a literal name.
+type TableProperties struct {
+	CatalogID    string `json:"CatalogId"`
+	DatabaseName interface{}
+	TableInput   TableInput
+}
+
+// TableInput describes the Glue table being created.
+type TableInput struct {
+	Name        string
+	Description string
+}
+
+// NewTable returns the CloudFormation resource for a Glue table in the database
+// identified by databaseName, which may be a literal name or an intrinsic
+// referencing the database resource.
+func NewTable(catalogID string, databaseName interface{}, name, description string) *Table {
+	return &Table{
+		Type: tableResourceType,
+		Properties: TableProperties{
+			CatalogID:    catalogID,
+			DatabaseName: databaseName,
+			TableInput: TableInput{
+				Name:        name,
+				Description: description,
+			},
+		},
+	}
+}
+
+func init() {
+	cfngen.RegisterResource(tableResourceType, func() interface{} { return &Table{} })
+}
@@ -0,0 +1,37 @@
+package cfngen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// resourceFactories maps a CloudFormation resource "Type" (e.g.
+// "AWS::Glue::Database") to a constructor for the typed Go value
+// Template.UnmarshalJSON decodes that whole resource (its "Type" and
+// "Properties") into.
+var resourceFactories = map[string]func() interface{}{}
+
+// RegisterResource installs factory as the constructor used whenever
+// Template.UnmarshalJSON encounters a resource of resourceType. factory must
+// return a pointer suitable as a json.Unmarshal target (typically a pointer to
+// the same resource struct the package's constructor, e.g. NewDatabase,
+// returns). Packages that generate CloudFormation resources call this from an
+// init() so that parsing an existing template reconstitutes typed values
+// instead of generic maps. Callers with their own custom resources can
+// register factories for them the same way.
+func RegisterResource(resourceType string, factory func() interface{}) {
+	resourceFactories[resourceType] = factory
+}
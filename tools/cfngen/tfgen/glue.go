@@ -0,0 +1,122 @@
+package tfgen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import "fmt"
+
+// terraformAddresses maps a CloudFormation resource "Type" to the Terraform
+// resource type it's emitted as.
+var terraformAddresses = map[string]string{
+	"AWS::Glue::Database": "aws_glue_catalog_database",
+	"AWS::Glue::Table":    "aws_glue_catalog_table",
+	"AWS::Glue::Crawler":  "aws_glue_crawler",
+}
+
+// emitters maps a CloudFormation resource "Type" to the function that renders
+// it as an HCL resource block.
+var emitters = map[string]func(logicalID string, properties map[string]interface{}, refs map[string]string) (string, error){
+	"AWS::Glue::Database": emitDatabase,
+	"AWS::Glue::Table":    emitTable,
+	"AWS::Glue::Crawler":  emitCrawler,
+}
+
+func emitDatabase(logicalID string, properties map[string]interface{}, refs map[string]string) (string, error) {
+	input, _ := properties["DatabaseInput"].(map[string]interface{})
+
+	var attrs []hclAttr
+	var err error
+
+	catalogID, ok := properties["CatalogId"]
+	if attrs, err = appendAttr(attrs, "catalog_id", catalogID, ok, refs); err != nil {
+		return "", err
+	}
+	name, ok := input["Name"]
+	if attrs, err = appendAttr(attrs, "name", name, ok, refs); err != nil {
+		return "", err
+	}
+	description, ok := input["Description"]
+	if attrs, err = appendAttr(attrs, "description", description, ok, refs); err != nil {
+		return "", err
+	}
+
+	return renderResource(terraformAddresses["AWS::Glue::Database"], logicalID, attrs, nil), nil
+}
+
+func emitTable(logicalID string, properties map[string]interface{}, refs map[string]string) (string, error) {
+	input, _ := properties["TableInput"].(map[string]interface{})
+
+	var attrs []hclAttr
+	var err error
+
+	catalogID, ok := properties["CatalogId"]
+	if attrs, err = appendAttr(attrs, "catalog_id", catalogID, ok, refs); err != nil {
+		return "", err
+	}
+	databaseName, ok := properties["DatabaseName"]
+	if attrs, err = appendAttr(attrs, "database_name", databaseName, ok, refs); err != nil {
+		return "", err
+	}
+	name, ok := input["Name"]
+	if attrs, err = appendAttr(attrs, "name", name, ok, refs); err != nil {
+		return "", err
+	}
+	description, ok := input["Description"]
+	if attrs, err = appendAttr(attrs, "description", description, ok, refs); err != nil {
+		return "", err
+	}
+
+	return renderResource(terraformAddresses["AWS::Glue::Table"], logicalID, attrs, nil), nil
+}
+
+func emitCrawler(logicalID string, properties map[string]interface{}, refs map[string]string) (string, error) {
+	var attrs []hclAttr
+	var err error
+
+	name, ok := properties["Name"]
+	if attrs, err = appendAttr(attrs, "name", name, ok, refs); err != nil {
+		return "", err
+	}
+	role, ok := properties["Role"]
+	if attrs, err = appendAttr(attrs, "role", role, ok, refs); err != nil {
+		return "", err
+	}
+	databaseName, ok := properties["DatabaseName"]
+	if attrs, err = appendAttr(attrs, "database_name", databaseName, ok, refs); err != nil {
+		return "", err
+	}
+
+	targets, _ := properties["Targets"].(map[string]interface{})
+	s3Targets, _ := targets["S3Targets"].([]interface{})
+	blocks := make([]hclNestedBlock, 0, len(s3Targets))
+	for _, target := range s3Targets {
+		targetProps, ok := target.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("malformed S3Target %#v", target)
+		}
+
+		var targetAttrs []hclAttr
+		path, ok := targetProps["Path"]
+		if targetAttrs, err = appendAttr(targetAttrs, "path", path, ok, refs); err != nil {
+			return "", err
+		}
+		blocks = append(blocks, hclNestedBlock{name: "s3_target", attrs: targetAttrs})
+	}
+
+	return renderResource(terraformAddresses["AWS::Glue::Crawler"], logicalID, attrs, blocks), nil
+}
@@ -0,0 +1,76 @@
+package tfgen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/tools/cfngen/gluecf"
+)
+
+func TestDatabase(t *testing.T) {
+	dbName := "db1"
+	resources := map[string]interface{}{
+		dbName: gluecf.NewDatabase("12345", dbName, "Test db"),
+	}
+
+	tf, err := Terraform(resources)
+	require.NoError(t, err)
+
+	const expectedFile = "testdata/db.tf"
+	// uncomment to write new expected file
+	// require.NoError(t, ioutil.WriteFile(expectedFile, tf, 0644))
+
+	expected, err := ioutil.ReadFile(expectedFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(tf))
+}
+
+// TestIntrinsics exercises Ref, Fn::GetAtt and Fn::Sub (both the bare
+// "${LogicalId}" and dotted "${LogicalId.Attribute}" forms) across a small
+// resource graph, so a regression in any one translation - like a wrong
+// Terraform resource type name - shows up as a golden-file diff.
+func TestIntrinsics(t *testing.T) {
+	resources := map[string]interface{}{
+		"db1":    gluecf.NewDatabase("12345", "db1", "Test db"),
+		"table1": gluecf.NewTable("12345", map[string]interface{}{"Ref": "db1"}, "table1", "Test table"),
+		"crawler1": gluecf.NewCrawler(
+			"crawler1",
+			map[string]interface{}{"Fn::GetAtt": []interface{}{"db1", "Arn"}},
+			map[string]interface{}{"Ref": "db1"},
+			map[string]interface{}{"Fn::Sub": "s3://${db1}/data"},
+			map[string]interface{}{"Fn::Sub": "s3://${db1.Arn}/logs"},
+		),
+	}
+
+	tf, err := Terraform(resources)
+	require.NoError(t, err)
+
+	const expectedFile = "testdata/graph.tf"
+	// uncomment to write new expected file
+	// require.NoError(t, ioutil.WriteFile(expectedFile, tf, 0644))
+
+	expected, err := ioutil.ReadFile(expectedFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(tf))
+}
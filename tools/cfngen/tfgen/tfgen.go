@@ -0,0 +1,311 @@
+// Package tfgen renders the same CloudFormation resource maps that
+// tools/cfngen (via gluecf, etc.) turns into templates as Terraform HCL
+// instead, for users running on a Terraform-first stack.
+package tfgen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Terraform renders resources (the same logical-name -> resource map passed to
+// cfngen.NewTemplate) as Terraform HCL resource blocks, in logical-name order.
+//
+// Resources are translated by their CloudFormation "Type", so only types with
+// an emitter registered in this package (see glue.go) can be rendered; any
+// resource may still be the target of a Ref, Fn::GetAtt or Fn::Sub from one
+// that is, as long as its type is in terraformAddresses.
+func Terraform(resources map[string]interface{}) ([]byte, error) {
+	refs, err := resourceRefs(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for i, name := range names {
+		block, err := terraformResource(name, resources[name], refs)
+		if err != nil {
+			return nil, fmt.Errorf("resource %q: %w", name, err)
+		}
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(block)
+	}
+	return buf.Bytes(), nil
+}
+
+// resourceRefs maps each logical name in resources to the Terraform resource
+// address (e.g. "aws_glue_catalog_database.db1") it will be emitted as, so
+// Ref/Fn::GetAtt/Fn::Sub elsewhere in the graph can be resolved regardless of
+// which resource happens to be emitted first.
+func resourceRefs(resources map[string]interface{}) (map[string]string, error) {
+	refs := make(map[string]string, len(resources))
+	for name, resource := range resources {
+		cfType, _, err := resourceEnvelope(resource)
+		if err != nil {
+			return nil, fmt.Errorf("resource %q: %w", name, err)
+		}
+		if tfType, ok := terraformAddresses[cfType]; ok {
+			refs[name] = fmt.Sprintf("%s.%s", tfType, terraformName(name))
+		}
+	}
+	return refs, nil
+}
+
+func terraformResource(name string, resource interface{}, refs map[string]string) (string, error) {
+	cfType, properties, err := resourceEnvelope(resource)
+	if err != nil {
+		return "", err
+	}
+
+	emit, ok := emitters[cfType]
+	if !ok {
+		return "", fmt.Errorf("no terraform emitter registered for CloudFormation type %s", cfType)
+	}
+	return emit(name, properties, refs)
+}
+
+// resourceEnvelope round-trips resource through JSON, the same encoding
+// gluecf resources already support, to read its "Type" and "Properties"
+// without every emitter needing its own type assertion.
+func resourceEnvelope(resource interface{}) (cfType string, properties map[string]interface{}, err error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var envelope struct {
+		Type       string
+		Properties map[string]interface{}
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return "", nil, err
+	}
+	return envelope.Type, envelope.Properties, nil
+}
+
+// terraformName sanitizes a CloudFormation logical ID into a valid Terraform
+// resource name.
+func terraformName(logicalID string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, logicalID)
+}
+
+// hclAttr is a single `name = value` line inside a resource block; value must
+// already be a valid HCL expression (quoted if it's a string literal).
+type hclAttr struct {
+	name  string
+	value string
+}
+
+// appendAttr appends a `name = value` attribute to attrs if present is true,
+// translating value (a raw CloudFormation property, possibly an intrinsic)
+// into its HCL expression along the way. Emitters use it to skip properties
+// CloudFormation left unset rather than emitting `name = null`.
+func appendAttr(attrs []hclAttr, name string, value interface{}, present bool, refs map[string]string) ([]hclAttr, error) {
+	if !present {
+		return attrs, nil
+	}
+	hcl, err := hclValue(value, refs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return append(attrs, hclAttr{name, hcl}), nil
+}
+
+// hclNestedBlock is a nested `name { ... }` block inside a resource, such as
+// the `s3_target` blocks on an aws_glue_crawler.
+type hclNestedBlock struct {
+	name  string
+	attrs []hclAttr
+}
+
+func renderResource(resourceType, logicalID string, attrs []hclAttr, blocks []hclNestedBlock) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "resource %q %q {\n", resourceType, terraformName(logicalID))
+	for _, attr := range attrs {
+		fmt.Fprintf(&buf, "  %s = %s\n", attr.name, attr.value)
+	}
+	for _, block := range blocks {
+		buf.WriteString("\n")
+		fmt.Fprintf(&buf, "  %s {\n", block.name)
+		for _, attr := range block.attrs {
+			fmt.Fprintf(&buf, "    %s = %s\n", attr.name, attr.value)
+		}
+		buf.WriteString("  }\n")
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// hclValue translates a generic CloudFormation property value - as produced by
+// json.Unmarshal into interface{} - into an HCL expression, resolving Ref,
+// Fn::GetAtt and Fn::Sub against refs.
+func hclValue(v interface{}, refs map[string]string) (string, error) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		return hclIntrinsic(value, refs)
+	case []interface{}:
+		parts := make([]string, len(value))
+		for i, elem := range value {
+			part, err := hclValue(elem, refs)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = part
+		}
+		return "[" + strings.Join(parts, ", ") + "]", nil
+	case string:
+		return strconv.Quote(value), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case nil:
+		return "null", nil
+	default:
+		return "", fmt.Errorf("unsupported HCL value type %T", value)
+	}
+}
+
+func hclIntrinsic(m map[string]interface{}, refs map[string]string) (string, error) {
+	if len(m) != 1 {
+		return "", fmt.Errorf("unsupported object value %#v", m)
+	}
+
+	if logicalID, ok := m["Ref"].(string); ok {
+		addr, err := resolveRef(logicalID, refs)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Quote(fmt.Sprintf("${%s.id}", addr)), nil
+	}
+
+	if sub, ok := m["Fn::Sub"].(string); ok {
+		return hclSub(sub, refs)
+	}
+
+	if getAtt, ok := m["Fn::GetAtt"]; ok {
+		return hclGetAtt(getAtt, refs)
+	}
+
+	return "", fmt.Errorf("unsupported intrinsic %#v", m)
+}
+
+func resolveRef(logicalID string, refs map[string]string) (string, error) {
+	addr, ok := refs[logicalID]
+	if !ok {
+		return "", fmt.Errorf("reference to unknown resource %q", logicalID)
+	}
+	return addr, nil
+}
+
+func hclGetAtt(v interface{}, refs map[string]string) (string, error) {
+	var logicalID, attribute string
+	switch attr := v.(type) {
+	case string:
+		parts := strings.SplitN(attr, ".", 2)
+		if len(parts) != 2 {
+			return "", fmt.Errorf("malformed Fn::GetAtt %q", attr)
+		}
+		logicalID, attribute = parts[0], parts[1]
+	case []interface{}:
+		if len(attr) != 2 {
+			return "", fmt.Errorf("malformed Fn::GetAtt %v", attr)
+		}
+		id, idOK := attr[0].(string)
+		name, nameOK := attr[1].(string)
+		if !idOK || !nameOK {
+			return "", fmt.Errorf("malformed Fn::GetAtt %v", attr)
+		}
+		logicalID, attribute = id, name
+	default:
+		return "", fmt.Errorf("unsupported Fn::GetAtt value %#v", v)
+	}
+
+	addr, err := resolveRef(logicalID, refs)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Quote(fmt.Sprintf("${%s.%s}", addr, attribute)), nil
+}
+
+// resolvePlaceholder resolves a single Fn::Sub "${...}" placeholder body
+// against refs: a bare "LogicalId" resolves to that resource's id, the same
+// as a Ref would, while "LogicalId.Attribute" resolves like Fn::GetAtt does.
+func resolvePlaceholder(placeholder string, refs map[string]string) (string, error) {
+	parts := strings.SplitN(placeholder, ".", 2)
+
+	addr, err := resolveRef(parts[0], refs)
+	if err != nil {
+		return "", err
+	}
+	if len(parts) == 1 {
+		return fmt.Sprintf("${%s.id}", addr), nil
+	}
+	return fmt.Sprintf("${%s.%s}", addr, parts[1]), nil
+}
+
+// hclSub rewrites a CloudFormation Fn::Sub template string into an
+// interpolated HCL string, replacing each "${LogicalId}" or
+// "${LogicalId.Attribute}" placeholder with the matching Terraform resource's
+// id or attribute, respectively.
+func hclSub(sub string, refs map[string]string) (string, error) {
+	var out strings.Builder
+	for {
+		start := strings.Index(sub, "${")
+		if start < 0 {
+			out.WriteString(sub)
+			break
+		}
+		end := strings.Index(sub[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("malformed Fn::Sub %q", sub)
+		}
+		end += start
+
+		out.WriteString(sub[:start])
+		placeholder, err := resolvePlaceholder(sub[start+2:end], refs)
+		if err != nil {
+			return "", fmt.Errorf("Fn::Sub: %w", err)
+		}
+		out.WriteString(placeholder)
+		sub = sub[end+1:]
+	}
+	return strconv.Quote(out.String()), nil
+}
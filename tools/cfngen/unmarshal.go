@@ -0,0 +1,83 @@
+package cfngen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON parses an existing CloudFormation template, dispatching each
+// resource to the factory registered for its "Type" (see RegisterResource) so
+// that, for example, an "AWS::Glue::Database" resource comes back as a
+// *gluecf.Database rather than a map[string]interface{}. Resources whose type
+// has no registered factory are left as generic maps so the template still
+// round-trips in full.
+func (t *Template) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		AWSTemplateFormatVersion string
+		Description              string
+		Parameters               map[string]interface{}
+		Resources                map[string]json.RawMessage
+		Outputs                  map[string]interface{}
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	resources := make(map[string]interface{}, len(envelope.Resources))
+	for name, raw := range envelope.Resources {
+		resource, err := unmarshalResource(raw)
+		if err != nil {
+			return fmt.Errorf("resource %q: %w", name, err)
+		}
+		resources[name] = resource
+	}
+
+	t.AWSTemplateFormatVersion = envelope.AWSTemplateFormatVersion
+	t.Description = envelope.Description
+	t.Parameters = envelope.Parameters
+	t.Resources = resources
+	t.Outputs = envelope.Outputs
+	return nil
+}
+
+func unmarshalResource(raw json.RawMessage) (interface{}, error) {
+	var typed struct {
+		Type string
+	}
+	if err := json.Unmarshal(raw, &typed); err != nil {
+		return nil, err
+	}
+
+	factory, ok := resourceFactories[typed.Type]
+	if !ok {
+		var generic map[string]interface{}
+		if err := json.Unmarshal(raw, &generic); err != nil {
+			return nil, err
+		}
+		return generic, nil
+	}
+
+	resource := factory()
+	if err := json.Unmarshal(raw, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
@@ -0,0 +1,147 @@
+package cfngen
+
+/**
+ * Panther is a scalable, powerful, cloud-native SIEM written in Golang/React.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlNode converts a generic JSON value (as produced by json.Unmarshal into
+// interface{}) into a *yaml.Node tree, rewriting single-key maps that are
+// CloudFormation intrinsic functions into their short-form tagged scalars.
+func yamlNode(v interface{}) (*yaml.Node, error) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if node := intrinsicNode(value); node != nil {
+			return node, nil
+		}
+		return mappingNode(value)
+	case []interface{}:
+		return sequenceNode(value)
+	default:
+		node := &yaml.Node{}
+		if err := node.Encode(value); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+}
+
+// intrinsicNode returns a short-form tagged scalar node for a single-key map
+// matching one of the intrinsics CloudFormation supports in short form, or nil
+// if m isn't one of those (or the value can't be represented unambiguously in
+// short form, in which case the caller falls back to a long-form mapping).
+func intrinsicNode(m map[string]interface{}) *yaml.Node {
+	if len(m) != 1 {
+		return nil
+	}
+
+	switch {
+	case isString(m["Ref"]):
+		return taggedScalar("!Ref", m["Ref"].(string))
+
+	case m["Fn::Sub"] != nil:
+		if sub, ok := m["Fn::Sub"].(string); ok {
+			return taggedScalar("!Sub", sub)
+		}
+		return nil // the two-element [template, vars] form has no unambiguous short form
+
+	case m["Fn::GetAtt"] != nil:
+		return getAttNode(m["Fn::GetAtt"])
+
+	default:
+		return nil
+	}
+}
+
+// getAttNode renders Fn::GetAtt as !GetAtt logicalId.attribute when the
+// arguments are plain strings and the logical ID itself contains no dots
+// (which would make the short form ambiguous to parse back); otherwise it
+// returns nil so the caller falls back to the long form.
+func getAttNode(v interface{}) *yaml.Node {
+	switch attr := v.(type) {
+	case string:
+		return taggedScalar("!GetAtt", attr)
+	case []interface{}:
+		if len(attr) < 2 {
+			return nil
+		}
+		parts := make([]string, len(attr))
+		for i, p := range attr {
+			s, ok := p.(string)
+			if !ok {
+				return nil
+			}
+			parts[i] = s
+		}
+		if strings.Contains(parts[0], ".") {
+			return nil
+		}
+		return taggedScalar("!GetAtt", strings.Join(parts, "."))
+	default:
+		return nil
+	}
+}
+
+func taggedScalar(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+func isString(v interface{}) bool {
+	_, ok := v.(string)
+	return ok
+}
+
+func mappingNode(m map[string]interface{}) (*yaml.Node, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, k := range keys {
+		keyNode := &yaml.Node{}
+		if err := keyNode.Encode(k); err != nil {
+			return nil, err
+		}
+		valueNode, err := yamlNode(m[k])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		node.Content = append(node.Content, keyNode, valueNode)
+	}
+	return node, nil
+}
+
+func sequenceNode(s []interface{}) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for i, v := range s {
+		valueNode, err := yamlNode(v)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		node.Content = append(node.Content, valueNode)
+	}
+	return node, nil
+}